@@ -0,0 +1,252 @@
+package git_commands
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+)
+
+// NewWorktreeOpts describes a `git worktree add` invocation.
+type NewWorktreeOpts struct {
+	Path   string
+	Base   string
+	Branch string
+	Detach bool
+}
+
+// WorktreeCommands wraps the `git worktree` plumbing used by WorktreeHelper.
+type WorktreeCommands struct{}
+
+func NewWorktreeCommands() *WorktreeCommands {
+	return &WorktreeCommands{}
+}
+
+func (self *WorktreeCommands) runGit(args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return string(out), nil
+}
+
+func (self *WorktreeCommands) New(opts NewWorktreeOpts) error {
+	args := []string{"worktree", "add"}
+	if opts.Detach {
+		args = append(args, "--detach", opts.Path, opts.Base)
+	} else if opts.Branch != "" {
+		args = append(args, "-b", opts.Branch, opts.Path, opts.Base)
+	} else {
+		args = append(args, opts.Path, opts.Base)
+	}
+
+	_, err := self.runGit(args...)
+	return err
+}
+
+func (self *WorktreeCommands) Delete(path string, force bool) error {
+	args := []string{"worktree", "remove", path}
+	if force {
+		args = append(args, "--force")
+	}
+	_, err := self.runGit(args...)
+	return err
+}
+
+func (self *WorktreeCommands) Detach(path string) error {
+	_, err := self.runGit("-C", path, "checkout", "--detach")
+	return err
+}
+
+func (self *WorktreeCommands) Move(from string, to string) error {
+	_, err := self.runGit("worktree", "move", from, to)
+	return err
+}
+
+func (self *WorktreeCommands) Lock(path string, reason string) error {
+	_, err := self.runGit(lockArgs(path, reason)...)
+	return err
+}
+
+func lockArgs(path string, reason string) []string {
+	args := []string{"worktree", "lock"}
+	if reason != "" {
+		args = append(args, "--reason", reason)
+	}
+	return append(args, path)
+}
+
+func (self *WorktreeCommands) Unlock(path string) error {
+	_, err := self.runGit("worktree", "unlock", path)
+	return err
+}
+
+// HasSubmodules reports whether the worktree at path has a `.gitmodules`
+// file checked out at its root.
+func (self *WorktreeCommands) HasSubmodules(path string) bool {
+	_, err := os.Stat(filepath.Join(path, ".gitmodules"))
+	return err == nil
+}
+
+func (self *WorktreeCommands) IsCurrentWorktree(path string) bool {
+	pwd, err := os.Getwd()
+	return err == nil && pwd == path
+}
+
+// GetWorktrees lists all worktrees, including their lock state, by parsing
+// `git worktree list --porcelain`.
+func (self *WorktreeCommands) GetWorktrees() ([]*models.Worktree, error) {
+	out, err := self.runGit("worktree", "list", "--porcelain")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorktrees(out), nil
+}
+
+// parseWorktrees parses the blank-line-separated records emitted by
+// `git worktree list --porcelain`, e.g.:
+//
+//	worktree /path/to/main
+//	HEAD 0123456789abcdef0123456789abcdef01234567
+//	branch refs/heads/main
+//
+//	worktree /path/to/locked-worktree
+//	HEAD 0123456789abcdef0123456789abcdef01234567
+//	branch refs/heads/feature
+//	locked reason with spaces
+func parseWorktrees(porcelainOutput string) []*models.Worktree {
+	var worktrees []*models.Worktree
+
+	for _, record := range strings.Split(strings.TrimSpace(porcelainOutput), "\n\n") {
+		if record == "" {
+			continue
+		}
+
+		worktree := &models.Worktree{}
+		for _, line := range strings.Split(record, "\n") {
+			switch {
+			case strings.HasPrefix(line, "worktree "):
+				worktree.Path = strings.TrimPrefix(line, "worktree ")
+			case strings.HasPrefix(line, "branch "):
+				worktree.Branch = strings.TrimPrefix(line, "branch refs/heads/")
+			case line == "bare":
+				worktree.IsMain = true
+			case strings.HasPrefix(line, "locked"):
+				worktree.Locked = true
+				worktree.LockReason = strings.TrimSpace(strings.TrimPrefix(line, "locked"))
+			}
+		}
+		worktrees = append(worktrees, worktree)
+	}
+
+	if len(worktrees) > 0 {
+		worktrees[0].IsMain = true
+	}
+
+	return worktrees
+}
+
+// WorktreeStatus summarizes the uncommitted changes in a worktree, mirroring
+// the staged/unstaged/untracked breakdown of `git status --porcelain=v1`.
+type WorktreeStatus struct {
+	StagedCount    int
+	UnstagedCount  int
+	UntrackedCount int
+}
+
+func (s *WorktreeStatus) IsDirty() bool {
+	return s.StagedCount > 0 || s.UnstagedCount > 0 || s.UntrackedCount > 0
+}
+
+// Status inspects the given worktree's index and working tree, without
+// switching into it, so callers can warn before a destructive operation.
+func (self *WorktreeCommands) Status(path string) (*WorktreeStatus, error) {
+	out, err := self.runGit("-C", path, "status", "--porcelain=v1")
+	if err != nil {
+		return nil, err
+	}
+
+	return parseWorktreeStatus(out), nil
+}
+
+func parseWorktreeStatus(porcelainOutput string) *WorktreeStatus {
+	status := &WorktreeStatus{}
+	for _, line := range strings.Split(porcelainOutput, "\n") {
+		if len(line) < 2 {
+			continue
+		}
+		indexStatus, worktreeStatus := line[0], line[1]
+		if indexStatus == '?' && worktreeStatus == '?' {
+			status.UntrackedCount++
+			continue
+		}
+		if indexStatus != ' ' {
+			status.StagedCount++
+		}
+		if worktreeStatus != ' ' {
+			status.UnstagedCount++
+		}
+	}
+
+	return status
+}
+
+// FetchPath runs `git fetch` scoped to the given worktree via `-C`, so the
+// caller doesn't need to switch into it first.
+func (self *WorktreeCommands) FetchPath(path string) error {
+	_, err := self.runGit("-C", path, "fetch")
+	return err
+}
+
+// PullPath runs `git pull` scoped to the given worktree via `-C`.
+func (self *WorktreeCommands) PullPath(path string, fastForwardOnly bool) error {
+	_, err := self.runGit(pullPathArgs(path, fastForwardOnly)...)
+	return err
+}
+
+func pullPathArgs(path string, fastForwardOnly bool) []string {
+	args := []string{"-C", path, "pull"}
+	if fastForwardOnly {
+		args = append(args, "--ff-only")
+	}
+	return args
+}
+
+// Prune removes administrative files for worktrees whose paths no longer
+// exist on disk. If expire is non-empty it's passed through as
+// `--expire <expire>`, restricting pruning to entries at least that old.
+func (self *WorktreeCommands) Prune(expire string) error {
+	_, err := self.runGit(pruneArgs(expire)...)
+	return err
+}
+
+func pruneArgs(expire string) []string {
+	args := []string{"worktree", "prune"}
+	if expire != "" {
+		args = append(args, "--expire", expire)
+	}
+	return args
+}
+
+// CommitAllPath stages everything (including untracked files) and commits it
+// in the given worktree, without switching into it.
+func (self *WorktreeCommands) CommitAllPath(path string, message string) error {
+	if _, err := self.runGit("-C", path, "add", "-A"); err != nil {
+		return err
+	}
+	_, err := self.runGit("-C", path, "commit", "-m", message)
+	return err
+}
+
+// StashPath runs `git stash push` scoped to the given worktree, capturing
+// both staged and unstaged changes (but not untracked files, to mirror plain
+// `git stash`'s default behaviour).
+func (self *WorktreeCommands) StashPath(path string, message string) error {
+	_, err := self.runGit("-C", path, "stash", "push", "-m", message)
+	return err
+}
@@ -0,0 +1,82 @@
+package git_commands
+
+import (
+	"testing"
+
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPruneArgs(t *testing.T) {
+	assert.Equal(t, []string{"worktree", "prune"}, pruneArgs(""))
+	assert.Equal(t, []string{"worktree", "prune", "--expire", "3.days.ago"}, pruneArgs("3.days.ago"))
+}
+
+func TestLockArgs(t *testing.T) {
+	assert.Equal(t, []string{"worktree", "lock", "/repo-feature"}, lockArgs("/repo-feature", ""))
+	assert.Equal(t, []string{"worktree", "lock", "--reason", "removable media", "/repo-feature"}, lockArgs("/repo-feature", "removable media"))
+}
+
+func TestPullPathArgs(t *testing.T) {
+	assert.Equal(t, []string{"-C", "/repo-feature", "pull"}, pullPathArgs("/repo-feature", false))
+	assert.Equal(t, []string{"-C", "/repo-feature", "pull", "--ff-only"}, pullPathArgs("/repo-feature", true))
+}
+
+func TestParseWorktrees(t *testing.T) {
+	input := "worktree /repo\n" +
+		"HEAD 0123456789abcdef0123456789abcdef01234567\n" +
+		"branch refs/heads/main\n" +
+		"\n" +
+		"worktree /repo-feature\n" +
+		"HEAD 89abcdef0123456789abcdef0123456789abcdef\n" +
+		"branch refs/heads/feature\n" +
+		"locked removable media\n"
+
+	worktrees := parseWorktrees(input)
+
+	assert.Equal(t, []*models.Worktree{
+		{Path: "/repo", Branch: "main", IsMain: true},
+		{Path: "/repo-feature", Branch: "feature", Locked: true, LockReason: "removable media"},
+	}, worktrees)
+}
+
+func TestParseWorktreeStatus(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		output   string
+		expected WorktreeStatus
+		dirty    bool
+	}{
+		{
+			name:     "clean",
+			output:   "",
+			expected: WorktreeStatus{},
+			dirty:    false,
+		},
+		{
+			name:     "staged, unstaged, and untracked changes",
+			output:   "M  staged.txt\n M unstaged.txt\n?? untracked.txt\n",
+			expected: WorktreeStatus{StagedCount: 1, UnstagedCount: 1, UntrackedCount: 1},
+			dirty:    true,
+		},
+		{
+			name:     "staged only",
+			output:   "A  new_file.txt\n",
+			expected: WorktreeStatus{StagedCount: 1},
+			dirty:    true,
+		},
+	}
+
+	for _, s := range scenarios {
+		s := s
+		t.Run(s.name, func(t *testing.T) {
+			actual := parseWorktreeStatus(s.output)
+			if *actual != s.expected {
+				t.Errorf("expected %+v, got %+v", s.expected, *actual)
+			}
+			if actual.IsDirty() != s.dirty {
+				t.Errorf("expected IsDirty() == %v, got %v", s.dirty, actual.IsDirty())
+			}
+		})
+	}
+}
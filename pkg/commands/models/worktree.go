@@ -0,0 +1,40 @@
+package models
+
+import "path/filepath"
+
+// Worktree represents a single entry parsed from `git worktree list --porcelain`.
+type Worktree struct {
+	Path   string
+	Branch string
+	IsMain bool
+
+	// Locked and LockReason come from the porcelain `locked[ <reason>]` line.
+	// A locked worktree is protected from `git worktree remove`/`prune`.
+	Locked     bool
+	LockReason string
+}
+
+func (w *Worktree) RefName() string {
+	return w.Branch
+}
+
+func (w *Worktree) ID() string {
+	return w.Path
+}
+
+func (w *Worktree) Main() bool {
+	return w.IsMain
+}
+
+func (w *Worktree) Name() string {
+	return filepath.Base(w.Path)
+}
+
+// DisplayName is what the worktrees view renders for this entry: the bare
+// name, with a lock indicator appended for locked worktrees.
+func (w *Worktree) DisplayName() string {
+	if w.Locked {
+		return w.Name() + " \U0001F512"
+	}
+	return w.Name()
+}
@@ -0,0 +1,23 @@
+package config
+
+// UserConfig mirrors the subset of the user's config.yml that the worktree
+// helper cares about. The real config struct has many more fields; only the
+// ones this package touches are modelled here.
+type UserConfig struct {
+	Git GitConfig
+}
+
+type GitConfig struct {
+	Worktree WorktreeConfig
+}
+
+// WorktreeConfig holds `git.worktree.*` settings.
+type WorktreeConfig struct {
+	// PullFastForwardOnly makes WorktreeHelper.Pull run `git pull --ff-only`
+	// instead of a plain `git pull` against the selected worktree.
+	PullFastForwardOnly bool
+
+	// AutoInitSubmodules makes newly created worktrees run
+	// `git submodule update --init --recursive` without prompting first.
+	AutoInitSubmodules bool
+}
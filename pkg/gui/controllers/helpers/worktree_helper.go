@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io/fs"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/jesseduffield/gocui"
@@ -12,6 +13,7 @@ import (
 	"github.com/jesseduffield/lazygit/pkg/gui/context"
 	"github.com/jesseduffield/lazygit/pkg/gui/types"
 	"github.com/jesseduffield/lazygit/pkg/utils"
+	"github.com/samber/lo"
 )
 
 type IWorktreeHelper interface {
@@ -24,14 +26,16 @@ type WorktreeHelper struct {
 	reposHelper       *ReposHelper
 	refsHelper        *RefsHelper
 	suggestionsHelper *SuggestionsHelper
+	submodulesHelper  *SubmodulesHelper
 }
 
-func NewWorktreeHelper(c *HelperCommon, reposHelper *ReposHelper, refsHelper *RefsHelper, suggestionsHelper *SuggestionsHelper) *WorktreeHelper {
+func NewWorktreeHelper(c *HelperCommon, reposHelper *ReposHelper, refsHelper *RefsHelper, suggestionsHelper *SuggestionsHelper, submodulesHelper *SubmodulesHelper) *WorktreeHelper {
 	return &WorktreeHelper{
 		c:                 c,
 		reposHelper:       reposHelper,
 		refsHelper:        refsHelper,
 		suggestionsHelper: suggestionsHelper,
+		submodulesHelper:  submodulesHelper,
 	}
 }
 
@@ -55,6 +59,17 @@ func (self *WorktreeHelper) IsCurrentWorktree(w *models.Worktree) bool {
 	return pwd == w.Path
 }
 
+// DisplayNameForList is what the worktrees view renders for an entry: its
+// display name (including any lock indicator) plus a passive badge for
+// worktrees whose path is missing, so users have a way to discover Prune.
+func (self *WorktreeHelper) DisplayNameForList(w *models.Worktree) string {
+	name := w.DisplayName()
+	if self.IsWorktreePathMissing(w) {
+		name += " " + self.c.Tr.WorktreeMissingBadge
+	}
+	return name
+}
+
 func (self *WorktreeHelper) IsWorktreePathMissing(w *models.Worktree) bool {
 	if _, err := os.Stat(w.Path); err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
@@ -66,6 +81,119 @@ func (self *WorktreeHelper) IsWorktreePathMissing(w *models.Worktree) bool {
 	return false
 }
 
+// Prune finds worktrees whose paths are missing on disk and presents a
+// multi-select menu so the user can pick exactly which ones to prune, rather
+// than an all-or-nothing confirmation. Stale worktrees are the ones for which
+// IsWorktreePathMissing holds, the same check that drives the list badge.
+func (self *WorktreeHelper) Prune() error {
+	stale := lo.Filter(self.c.Model().Worktrees, func(w *models.Worktree, _ int) bool {
+		return self.IsWorktreePathMissing(w)
+	})
+
+	if len(stale) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoStaleWorktreesFound)
+	}
+
+	selected := make(map[string]bool, len(stale))
+	for _, w := range stale {
+		selected[w.Path] = true
+	}
+
+	var showMenu func() error
+	showMenu = func() error {
+		items := make([]*types.MenuItem, 0, len(stale)+1)
+		for _, w := range stale {
+			w := w
+			checkbox := "[ ]"
+			if selected[w.Path] {
+				checkbox = "[x]"
+			}
+			items = append(items, &types.MenuItem{
+				LabelColumns: []string{checkbox, w.Name()},
+				OnPress: func() error {
+					selected[w.Path] = !selected[w.Path]
+					return showMenu()
+				},
+			})
+		}
+
+		toPrune := lo.Filter(stale, func(w *models.Worktree, _ int) bool { return selected[w.Path] })
+		items = append(items, &types.MenuItem{
+			LabelColumns: []string{utils.ResolvePlaceholderString(
+				self.c.Tr.PruneSelectedWorktrees,
+				map[string]string{"count": strconv.Itoa(len(toPrune))},
+			)},
+			OnPress: func() error {
+				return self.confirmPruneWorktrees(toPrune, len(toPrune) == len(stale))
+			},
+		})
+
+		return self.c.Menu(types.CreateMenuOptions{Title: self.c.Tr.PruneWorktreesTitle, Items: items})
+	}
+
+	return showMenu()
+}
+
+// confirmPruneWorktrees asks for confirmation (and, when every stale worktree
+// was selected, an optional `--expire` cutoff) and then removes the selected
+// worktrees. `git worktree prune` itself has no way to
+// target specific entries, so we only use it when every stale worktree was
+// selected; otherwise we remove the selected ones individually so that
+// deselected entries are left untouched.
+func (self *WorktreeHelper) confirmPruneWorktrees(selected []*models.Worktree, allSelected bool) error {
+	if len(selected) == 0 {
+		return self.c.ErrorMsg(self.c.Tr.NoWorktreesSelected)
+	}
+
+	names := lo.Map(selected, func(w *models.Worktree, _ int) string { return w.Name() })
+	message := utils.ResolvePlaceholderString(
+		self.c.Tr.PruneWorktreesPrompt,
+		map[string]string{"worktreeNames": strings.Join(names, "\n")},
+	)
+
+	prune := func(expire string) error {
+		return self.c.WithWaitingStatus(self.c.Tr.PruningWorktrees, func(gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.PruneWorktrees)
+
+			if allSelected {
+				if err := self.c.Git().Worktree.Prune(expire); err != nil {
+					return self.c.Error(err)
+				}
+			} else {
+				for _, w := range selected {
+					if err := self.c.Git().Worktree.Delete(w.Path, true); err != nil {
+						return self.c.Error(err)
+					}
+				}
+			}
+
+			return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+		})
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.PruneWorktreesTitle,
+		Prompt: message,
+		HandleConfirm: func() error {
+			// `git worktree prune --expire` is the only thing that understands
+			// an expiry cutoff; once the user has deselected any stale
+			// worktree we remove the rest individually, so there's nothing
+			// left for an expiry value to apply to. Only ask for one when it
+			// can actually be honored.
+			if !allSelected {
+				return prune("")
+			}
+
+			return self.c.Prompt(types.PromptOpts{
+				Title: self.c.Tr.PruneWorktreesExpiry,
+				HandleConfirm: func(expire string) error {
+					return prune(expire)
+				},
+			})
+		},
+	})
+}
+
 func (self *WorktreeHelper) NewWorktree() error {
 	branch := self.refsHelper.GetCheckedOutRef()
 	currentBranchName := branch.RefName()
@@ -111,13 +239,21 @@ func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase boo
 	}
 
 	f := func() error {
-		return self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
+		if err := self.c.WithWaitingStatus(self.c.Tr.AddingWorktree, func(gocui.Task) error {
 			self.c.LogAction(self.c.Tr.Actions.AddWorktree)
-			if err := self.c.Git().Worktree.New(opts); err != nil {
-				return err
-			}
-			return self.Switch(opts.Path, context.LOCAL_BRANCHES_CONTEXT_KEY)
-		})
+			return self.c.Git().Worktree.New(opts)
+		}); err != nil {
+			return err
+		}
+
+		// Runs its own confirmation/waiting-status; kept outside the block
+		// above so the "adding worktree" spinner has already finished by the
+		// time we might show the submodule-init prompt.
+		if err := self.initSubmodulesIfNeeded(opts.Path); err != nil {
+			return err
+		}
+
+		return self.Switch(opts.Path, context.LOCAL_BRANCHES_CONTEXT_KEY)
 	}
 
 	return self.c.Prompt(types.PromptOpts{
@@ -159,6 +295,35 @@ func (self *WorktreeHelper) NewWorktreeCheckout(base string, canCheckoutBase boo
 	})
 }
 
+// initSubmodulesIfNeeded offers to run `git submodule update --init
+// --recursive` in a freshly created worktree if the checked-out tree has
+// submodules, so the worktree isn't left in a half-checked-out state. When
+// git.worktree.autoInitSubmodules is set this happens without prompting.
+func (self *WorktreeHelper) initSubmodulesIfNeeded(path string) error {
+	if !self.c.Git().Worktree.HasSubmodules(path) {
+		return nil
+	}
+
+	update := func() error {
+		return self.c.WithWaitingStatus(self.c.Tr.InitializingSubmodules, func(task gocui.Task) error {
+			self.c.LogAction(self.c.Tr.Actions.UpdateSubmodule)
+			return self.submodulesHelper.UpdateSubmodulesForPath(path, task)
+		})
+	}
+
+	if self.c.UserConfig().Git.Worktree.AutoInitSubmodules {
+		return update()
+	}
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.InitializingSubmodules,
+		Prompt: self.c.Tr.InitSubmodulesWorktreePrompt,
+		HandleConfirm: func() error {
+			return update()
+		},
+	})
+}
+
 func (self *WorktreeHelper) Switch(path string, contextKey types.ContextKey) error {
 	if self.c.Git().Worktree.IsCurrentWorktree(path) {
 		return self.c.ErrorMsg(self.c.Tr.AlreadyInWorktree)
@@ -170,6 +335,162 @@ func (self *WorktreeHelper) Switch(path string, contextKey types.ContextKey) err
 }
 
 func (self *WorktreeHelper) Remove(worktree *models.Worktree, force bool) error {
+	if worktree.Locked {
+		return self.confirmRemoveLockedWorktree(worktree, force)
+	}
+
+	if !force {
+		status, err := self.c.Git().Worktree.Status(worktree.Path)
+		if err != nil {
+			return self.c.Error(err)
+		}
+
+		if status.IsDirty() {
+			return self.confirmRemoveDirtyWorktree(worktree, status)
+		}
+	}
+
+	return self.removeWorktree(worktree, force)
+}
+
+// confirmRemoveLockedWorktree is shown instead of the plain remove
+// confirmation when the worktree is locked, so that we can offer to unlock
+// it first rather than bubbling up git's raw "worktree is locked" error.
+func (self *WorktreeHelper) confirmRemoveLockedWorktree(worktree *models.Worktree, force bool) error {
+	message := utils.ResolvePlaceholderString(
+		self.c.Tr.RemoveLockedWorktreePrompt,
+		map[string]string{
+			"worktreeName": worktree.Name(),
+			"lockReason":   worktree.LockReason,
+		},
+	)
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.RemoveWorktreeTitle,
+		Prompt: message,
+		HandleConfirm: func() error {
+			return self.c.WithWaitingStatus(self.c.Tr.UnlockingWorktree, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.UnlockWorktree)
+				if err := self.c.Git().Worktree.Unlock(worktree.Path); err != nil {
+					return self.c.Error(err)
+				}
+
+				worktree.Locked = false
+				worktree.LockReason = ""
+
+				return self.Remove(worktree, force)
+			})
+		},
+	})
+}
+
+// Lock marks a worktree as locked so that `git worktree remove` and `git
+// worktree prune` refuse to touch it without an explicit unlock, which is
+// useful for worktrees on removable media or long-running feature branches.
+func (self *WorktreeHelper) Lock(worktree *models.Worktree, reason string) error {
+	return self.c.WithWaitingStatus(self.c.Tr.LockingWorktree, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.LockWorktree)
+		if err := self.c.Git().Worktree.Lock(worktree.Path, reason); err != nil {
+			return self.c.Error(err)
+		}
+
+		worktree.Locked = true
+		worktree.LockReason = reason
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+	})
+}
+
+func (self *WorktreeHelper) Unlock(worktree *models.Worktree) error {
+	return self.c.WithWaitingStatus(self.c.Tr.UnlockingWorktree, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.UnlockWorktree)
+		if err := self.c.Git().Worktree.Unlock(worktree.Path); err != nil {
+			return self.c.Error(err)
+		}
+
+		worktree.Locked = false
+		worktree.LockReason = ""
+
+		return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES}})
+	})
+}
+
+// PromptLock asks the user for a lock reason before locking the worktree. The
+// reason is optional, mirroring `git worktree lock [--reason <string>]`.
+func (self *WorktreeHelper) PromptLock(worktree *models.Worktree) error {
+	return self.c.Prompt(types.PromptOpts{
+		Title: self.c.Tr.LockWorktreePrompt,
+		HandleConfirm: func(reason string) error {
+			return self.Lock(worktree, reason)
+		},
+	})
+}
+
+// confirmRemoveDirtyWorktree is shown instead of the plain remove confirmation
+// when the target worktree has uncommitted changes, so that users don't
+// accidentally lose work by blindly confirming the force prompt.
+func (self *WorktreeHelper) confirmRemoveDirtyWorktree(worktree *models.Worktree, status *git_commands.WorktreeStatus) error {
+	message := utils.ResolvePlaceholderString(
+		self.c.Tr.WorktreeDirtyPrompt,
+		map[string]string{
+			"worktreeName": worktree.Name(),
+			"staged":       strconv.Itoa(status.StagedCount),
+			"unstaged":     strconv.Itoa(status.UnstagedCount),
+			"untracked":    strconv.Itoa(status.UntrackedCount),
+		},
+	)
+
+	return self.c.Confirm(types.ConfirmOpts{
+		Title:  self.c.Tr.WorktreeDirtyTitle,
+		Prompt: message,
+		HandleConfirm: func() error {
+			return self.c.Menu(types.CreateMenuOptions{
+				Title: self.c.Tr.WorktreeDirtyTitle,
+				Items: []*types.MenuItem{
+					{
+						LabelColumns: []string{self.c.Tr.StashUncommittedChanges},
+						OnPress: func() error {
+							return self.c.WithWaitingStatus(self.c.Tr.RemovingWorktree, func(gocui.Task) error {
+								message := utils.ResolvePlaceholderString(self.c.Tr.StashPrefix, map[string]string{"worktreeName": worktree.Name()})
+								if err := self.c.Git().Worktree.StashPath(worktree.Path, message); err != nil {
+									return self.c.Error(err)
+								}
+								return self.removeWorktree(worktree, false)
+							})
+						},
+					},
+					{
+						LabelColumns: []string{self.c.Tr.CommitChanges},
+						OnPress: func() error {
+							return self.c.Prompt(types.PromptOpts{
+								Title: self.c.Tr.CommitChangesPrompt,
+								HandleConfirm: func(message string) error {
+									if message == "" {
+										return self.c.ErrorMsg(self.c.Tr.CommitMessageCannotBeBlank)
+									}
+									return self.c.WithWaitingStatus(self.c.Tr.RemovingWorktree, func(gocui.Task) error {
+										if err := self.c.Git().Worktree.CommitAllPath(worktree.Path, message); err != nil {
+											return self.c.Error(err)
+										}
+										return self.removeWorktree(worktree, false)
+									})
+								},
+							})
+						},
+					},
+					{
+						LabelColumns: []string{self.c.Tr.DiscardAllChangesAndForceRemove},
+						OnPress: func() error {
+							return self.removeWorktree(worktree, true)
+						},
+					},
+				},
+			})
+		},
+	})
+}
+
+func (self *WorktreeHelper) removeWorktree(worktree *models.Worktree, force bool) error {
 	title := self.c.Tr.RemoveWorktreeTitle
 	var templateStr string
 	if force {
@@ -197,7 +518,7 @@ func (self *WorktreeHelper) Remove(worktree *models.Worktree, force bool) error
 					}
 
 					if !force {
-						return self.Remove(worktree, true)
+						return self.removeWorktree(worktree, true)
 					}
 					return self.c.ErrorMsg(errMessage)
 				}
@@ -219,6 +540,125 @@ func (self *WorktreeHelper) Detach(worktree *models.Worktree) error {
 	})
 }
 
+// Move prompts for a new path and relocates the worktree there via
+// `git worktree move`. If the worktree being moved is the one we're
+// currently sitting in, we need to follow it so that lazygit doesn't end up
+// pointed at a directory that no longer exists.
+func (self *WorktreeHelper) Move(worktree *models.Worktree) error {
+	isCurrentWorktree := self.IsCurrentWorktree(worktree)
+
+	return self.c.Prompt(types.PromptOpts{
+		Title:          self.c.Tr.NewWorktreePath,
+		InitialContent: worktree.Path,
+		HandleConfirm: func(path string) error {
+			return self.c.WithWaitingStatus(self.c.Tr.MovingWorktree, func(gocui.Task) error {
+				self.c.LogAction(self.c.Tr.Actions.MoveWorktree)
+				if err := self.c.Git().Worktree.Move(worktree.Path, path); err != nil {
+					return self.c.Error(err)
+				}
+
+				worktree.Path = path
+
+				if isCurrentWorktree {
+					if err := os.Chdir(path); err != nil {
+						return self.c.Error(err)
+					}
+				}
+
+				return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: []types.RefreshableView{types.WORKTREES, types.BRANCHES, types.FILES}})
+			})
+		},
+	})
+}
+
+// Fetch runs `git fetch` against the given worktree without switching into
+// it, so that other worktrees can be kept up to date from wherever the user
+// currently is.
+func (self *WorktreeHelper) Fetch(worktree *models.Worktree) error {
+	return self.c.WithWaitingStatus(self.c.Tr.FetchingWorktree, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.Fetch)
+		if err := self.c.Git().Worktree.FetchPath(worktree.Path); err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.refreshWorktree(worktree)
+	})
+}
+
+// Pull runs `git pull` against the given worktree without switching into it.
+// Whether this is fast-forward-only is governed by the
+// git.worktree.pullFastForwardOnly config.
+func (self *WorktreeHelper) Pull(worktree *models.Worktree) error {
+	fastForwardOnly := self.c.UserConfig().Git.Worktree.PullFastForwardOnly
+
+	return self.c.WithWaitingStatus(self.c.Tr.PullingWorktree, func(gocui.Task) error {
+		self.c.LogAction(self.c.Tr.Actions.Pull)
+		if err := self.c.Git().Worktree.PullPath(worktree.Path, fastForwardOnly); err != nil {
+			return self.c.Error(err)
+		}
+
+		return self.refreshWorktree(worktree)
+	})
+}
+
+// ViewWorktreeActionsMenu is the context menu offered from the Worktrees view
+// for an existing worktree (as opposed to ViewWorktreeOptions/
+// ViewBranchWorktreeOptions, which create new ones).
+func (self *WorktreeHelper) ViewWorktreeActionsMenu(worktree *models.Worktree) error {
+	return self.c.Menu(types.CreateMenuOptions{
+		Title: worktree.DisplayName(),
+		Items: []*types.MenuItem{
+			{
+				LabelColumns: []string{self.c.Tr.Pull},
+				OnPress: func() error {
+					return self.Pull(worktree)
+				},
+			},
+			{
+				LabelColumns: []string{self.c.Tr.Fetch},
+				OnPress: func() error {
+					return self.Fetch(worktree)
+				},
+			},
+			{
+				LabelColumns: []string{self.c.Tr.MoveWorktreeTitle},
+				OnPress: func() error {
+					return self.Move(worktree)
+				},
+			},
+			{
+				LabelColumns: []string{lo.Ternary(worktree.Locked, self.c.Tr.UnlockWorktreeTitle, self.c.Tr.LockWorktreeTitle)},
+				OnPress: func() error {
+					if worktree.Locked {
+						return self.Unlock(worktree)
+					}
+					return self.PromptLock(worktree)
+				},
+			},
+			{
+				LabelColumns: []string{self.c.Tr.RemoveWorktreeTitle},
+				OnPress: func() error {
+					return self.Remove(worktree, false)
+				},
+			},
+			{
+				LabelColumns: []string{self.c.Tr.DetachWorktreeTitle},
+				OnPress: func() error {
+					return self.Detach(worktree)
+				},
+			},
+		},
+	})
+}
+
+func (self *WorktreeHelper) refreshWorktree(worktree *models.Worktree) error {
+	scope := []types.RefreshableView{types.WORKTREES, types.BRANCHES}
+	if self.IsCurrentWorktree(worktree) {
+		scope = append(scope, types.FILES)
+	}
+	return self.c.Refresh(types.RefreshOptions{Mode: types.ASYNC, Scope: scope})
+}
+
 func (self *WorktreeHelper) ViewWorktreeOptions(context types.IListContext, ref string) error {
 	currentBranch := self.refsHelper.GetCheckedOutRef()
 	canCheckoutBase := context == self.c.Contexts().Branches && ref != currentBranch.RefName()
@@ -0,0 +1,59 @@
+package controllers
+
+import (
+	"github.com/jesseduffield/gocui"
+	"github.com/jesseduffield/lazygit/pkg/gui/types"
+)
+
+// WorktreesController wires up the two Worktrees-view actions that the
+// generic list controller machinery doesn't cover on its own: opening the
+// full action menu for the selected worktree, and pruning stale ones.
+type WorktreesController struct {
+	baseController
+	c *ControllerCommon
+}
+
+var _ types.IController = (*WorktreesController)(nil)
+
+func NewWorktreesController(c *ControllerCommon) *WorktreesController {
+	return &WorktreesController{
+		baseController: baseController{},
+		c:              c,
+	}
+}
+
+func (self *WorktreesController) Context() types.Context {
+	return self.c.Contexts().Worktrees
+}
+
+func (self *WorktreesController) GetKeybindings(opts types.KeybindingsOpts) []*types.Binding {
+	return []*types.Binding{
+		{
+			Key:         gocui.KeyEnter,
+			Handler:     self.viewActionsMenu,
+			Description: self.c.Tr.WorktreeTitle,
+		},
+		{
+			Key:         'p',
+			Handler:     self.prune,
+			Description: self.c.Tr.PruneWorktreesTitle,
+		},
+	}
+}
+
+func (self *WorktreesController) GetOnClick() func() error {
+	return self.viewActionsMenu
+}
+
+func (self *WorktreesController) viewActionsMenu() error {
+	worktree := self.c.Contexts().Worktrees.GetSelected()
+	if worktree == nil {
+		return nil
+	}
+
+	return self.c.Helpers().Worktree.ViewWorktreeActionsMenu(worktree)
+}
+
+func (self *WorktreesController) prune() error {
+	return self.c.Helpers().Worktree.Prune()
+}
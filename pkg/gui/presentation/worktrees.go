@@ -0,0 +1,19 @@
+package presentation
+
+import (
+	"github.com/jesseduffield/lazygit/pkg/commands/models"
+	"github.com/jesseduffield/lazygit/pkg/gui/controllers/helpers"
+)
+
+// GetWorktreeListDisplayStrings returns the row to render for each worktree
+// in the Worktrees view, via WorktreeHelper.DisplayNameForList so that locked
+// worktrees show a lock indicator and worktrees with a missing path show a
+// passive badge pointing users at Prune.
+func GetWorktreeListDisplayStrings(worktrees []*models.Worktree, worktreeHelper *helpers.WorktreeHelper) [][]string {
+	lines := make([][]string, 0, len(worktrees))
+	for _, worktree := range worktrees {
+		lines = append(lines, []string{worktreeHelper.DisplayNameForList(worktree)})
+	}
+
+	return lines
+}
@@ -0,0 +1,150 @@
+package i18n
+
+// TranslationSet holds every user-facing string lazygit renders. Only the
+// subset needed by the worktrees feature is modelled here; the real set has
+// many more fields for the rest of the app.
+type TranslationSet struct {
+	Actions Actions
+
+	WorktreeTitle              string
+	NewWorktreeBase            string
+	NewWorktreePath            string
+	NewBranchName              string
+	NewBranchNameLeaveBlank    string
+	BranchNameCannotBeBlank    string
+	CreateWorktreeFrom         string
+	CreateWorktreeFromDetached string
+	AddingWorktree             string
+	AlreadyInWorktree          string
+	SwitchToWorktree           string
+	ErrWorktreeMovedOrRemoved  string
+
+	RemoveWorktreeTitle       string
+	RemoveWorktreePrompt      string
+	ForceRemoveWorktreePrompt string
+	RemoveWorktree            string
+	RemovingWorktree          string
+	DetachWorktreeTitle       string
+	DetachingWorktree         string
+
+	WorktreeDirtyTitle              string
+	WorktreeDirtyPrompt             string
+	StashUncommittedChanges         string
+	StashPrefix                     string
+	CommitChanges                   string
+	CommitChangesPrompt             string
+	CommitMessageCannotBeBlank      string
+	DiscardAllChangesAndForceRemove string
+
+	Pull             string
+	Fetch            string
+	PullingWorktree  string
+	FetchingWorktree string
+
+	MoveWorktreeTitle string
+	MovingWorktree    string
+
+	LockWorktreeTitle          string
+	UnlockWorktreeTitle        string
+	LockWorktreePrompt         string
+	LockingWorktree            string
+	UnlockingWorktree          string
+	RemoveLockedWorktreePrompt string
+	WorktreeMissingBadge       string
+
+	InitSubmodulesWorktreePrompt string
+	InitializingSubmodules       string
+
+	PruneWorktreesTitle    string
+	NoStaleWorktreesFound  string
+	PruneSelectedWorktrees string
+	NoWorktreesSelected    string
+	PruneWorktreesPrompt   string
+	PruneWorktreesExpiry   string
+	PruningWorktrees       string
+}
+
+// Actions holds the strings logged to the user action log (shown alongside
+// the spinner and in lazygit's own git log of its actions).
+type Actions struct {
+	AddWorktree     string
+	Pull            string
+	Fetch           string
+	MoveWorktree    string
+	LockWorktree    string
+	UnlockWorktree  string
+	UpdateSubmodule string
+	PruneWorktrees  string
+}
+
+func EnglishTranslationSet() *TranslationSet {
+	return &TranslationSet{
+		Actions: Actions{
+			AddWorktree:     "Add worktree",
+			Pull:            "Pull worktree",
+			Fetch:           "Fetch worktree",
+			MoveWorktree:    "Move worktree",
+			LockWorktree:    "Lock worktree",
+			UnlockWorktree:  "Unlock worktree",
+			UpdateSubmodule: "Update submodule",
+			PruneWorktrees:  "Prune worktrees",
+		},
+
+		WorktreeTitle:              "Worktree",
+		NewWorktreeBase:            "Base branch/ref for new worktree (leave blank to use current branch)",
+		NewWorktreePath:            "Enter path for new worktree",
+		NewBranchName:              "New branch name",
+		NewBranchNameLeaveBlank:    "New branch name (leave blank to just checkout {{.default}})",
+		BranchNameCannotBeBlank:    "Branch name cannot be blank",
+		CreateWorktreeFrom:         "Create worktree from '{{.ref}}'",
+		CreateWorktreeFromDetached: "Create worktree from '{{.ref}}' detached",
+		AddingWorktree:             "Adding worktree",
+		AlreadyInWorktree:          "You are already in this worktree",
+		SwitchToWorktree:           "Switch to worktree",
+		ErrWorktreeMovedOrRemoved:  "This worktree has been moved or removed",
+
+		RemoveWorktreeTitle:       "Remove worktree",
+		RemoveWorktreePrompt:      "Are you sure you want to remove worktree '{{.worktreeName}}'?",
+		ForceRemoveWorktreePrompt: "'{{.worktreeName}}' contains modified or untracked files. Are you sure you want to remove it?",
+		RemoveWorktree:            "Remove worktree",
+		RemovingWorktree:          "Removing worktree",
+		DetachWorktreeTitle:       "Detach worktree",
+		DetachingWorktree:         "Detaching worktree",
+
+		WorktreeDirtyTitle:              "Worktree has uncommitted changes",
+		WorktreeDirtyPrompt:             "Worktree '{{.worktreeName}}' has {{.staged}} staged, {{.unstaged}} unstaged, and {{.untracked}} untracked change(s). What would you like to do?",
+		StashUncommittedChanges:         "Stash uncommitted changes, then remove",
+		StashPrefix:                     "On worktree {{.worktreeName}}",
+		CommitChanges:                   "Commit changes, then remove",
+		CommitChangesPrompt:             "Commit message",
+		CommitMessageCannotBeBlank:      "Commit message cannot be blank",
+		DiscardAllChangesAndForceRemove: "Discard all changes and force remove",
+
+		Pull:             "Pull",
+		Fetch:            "Fetch",
+		PullingWorktree:  "Pulling worktree",
+		FetchingWorktree: "Fetching worktree",
+
+		MoveWorktreeTitle: "Move worktree",
+		MovingWorktree:    "Moving worktree",
+
+		LockWorktreeTitle:          "Lock worktree",
+		UnlockWorktreeTitle:        "Unlock worktree",
+		LockWorktreePrompt:         "Reason for locking (optional)",
+		LockingWorktree:            "Locking worktree",
+		UnlockingWorktree:          "Unlocking worktree",
+		RemoveLockedWorktreePrompt: "Worktree '{{.worktreeName}}' is locked: {{.lockReason}}. Unlock and remove it?",
+		WorktreeMissingBadge:       "(missing)",
+
+		InitSubmodulesWorktreePrompt: "This worktree has submodules. Initialize and update them now?",
+		InitializingSubmodules:       "Initializing submodules",
+
+		PruneWorktreesTitle:    "Prune worktrees",
+		NoStaleWorktreesFound:  "No worktrees with a missing path were found",
+		PruneSelectedWorktrees: "Prune {{.count}} selected worktree(s)",
+		NoWorktreesSelected:    "No worktrees selected",
+		PruneWorktreesPrompt:   "Are you sure you want to prune the following worktree(s)?\n{{.worktreeNames}}",
+		PruneWorktreesExpiry:   "Only prune worktrees older than this time (leave blank for no cutoff, e.g. '3.days.ago')",
+		PruningWorktrees:       "Pruning worktrees",
+	}
+}